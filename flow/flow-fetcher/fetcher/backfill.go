@@ -0,0 +1,113 @@
+package fetcher
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+)
+
+// JobStatus is the lifecycle state of an async backfill Job.
+type JobStatus string
+
+const (
+	JobRunning   JobStatus = "running"
+	JobCompleted JobStatus = "completed"
+	JobFailed    JobStatus = "failed"
+)
+
+// Job tracks the state of an asynchronous backfill run, as returned by
+// JobManager.Status.
+type Job struct {
+	Id     string    `json:"id"`
+	Topic  string    `json:"topic"`
+	Status JobStatus `json:"status"`
+	Error  string    `json:"error,omitempty"`
+}
+
+// JobManager runs backfills in the background via Fetcher.Resume and lets
+// callers poll their status by job id, so the /backfill endpoint can
+// return immediately instead of blocking on a potentially long catch-up.
+type JobManager struct {
+	fetcher *Fetcher
+
+	mu             sync.Mutex
+	jobs           map[string]*Job
+	runningByTopic map[string]string
+}
+
+// NewJobManager returns a JobManager that runs backfills via fetcher.
+func NewJobManager(fetcher *Fetcher) *JobManager {
+	return &JobManager{
+		fetcher:        fetcher,
+		jobs:           make(map[string]*Job),
+		runningByTopic: make(map[string]string),
+	}
+}
+
+// Start kicks off an asynchronous Resume for topic and returns the job id
+// immediately. The job runs against a background context, independent of
+// any request that triggered it, so it isn't cancelled when the HTTP
+// response is written.
+//
+// If a Resume for topic is already running - whether started by an
+// earlier Start or by a direct Fetcher.Resume call made through this same
+// JobManager - Start returns that job's id instead of starting a second
+// one. Two concurrent Resume runs for the same topic would interleave
+// SetCursor calls and could regress the persisted cursor, so topics are
+// serialized one job at a time.
+func (jm *JobManager) Start(topic string) (string, error) {
+	jm.mu.Lock()
+	if runningId, ok := jm.runningByTopic[topic]; ok {
+		jm.mu.Unlock()
+		return runningId, nil
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		jm.mu.Unlock()
+		return "", err
+	}
+
+	job := &Job{Id: id, Topic: topic, Status: JobRunning}
+	jm.jobs[id] = job
+	jm.runningByTopic[topic] = id
+	jm.mu.Unlock()
+
+	go func() {
+		_, err := jm.fetcher.Resume(context.Background(), topic)
+
+		jm.mu.Lock()
+		defer jm.mu.Unlock()
+		delete(jm.runningByTopic, topic)
+		if err != nil {
+			job.Status = JobFailed
+			job.Error = err.Error()
+			return
+		}
+		job.Status = JobCompleted
+	}()
+
+	return id, nil
+}
+
+// Status returns the current state of job id. ok is false if no such job
+// is known.
+func (jm *JobManager) Status(id string) (Job, bool) {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+
+	job, ok := jm.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	return *job, true
+}
+
+func newJobID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,65 @@
+package fetcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk/client"
+	"google.golang.org/grpc"
+)
+
+// blockingFetcher's GetEventsForHeightRange doesn't return until release is
+// closed, so a test can hold a Resume run "in flight" to exercise
+// JobManager's per-topic dedup.
+type blockingFetcher struct {
+	release chan struct{}
+}
+
+func (f *blockingFetcher) GetEventsForHeightRange(ctx context.Context, query client.EventRangeQuery, opts ...grpc.CallOption) ([]client.BlockEvents, error) {
+	<-f.release
+	return nil, nil
+}
+
+func TestJobManagerStartDedupesConcurrentTopicRuns(t *testing.T) {
+	release := make(chan struct{})
+	fake := &blockingFetcher{release: release}
+	f := NewFetcher(fake, newMemCursorStore(), func(ctx context.Context) (uint64, error) { return 10, nil })
+	jm := NewJobManager(f)
+
+	firstId, err := jm.Start("A.x.Foo.Bar")
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	secondId, err := jm.Start("A.x.Foo.Bar")
+	if err != nil {
+		t.Fatalf("second Start: %v", err)
+	}
+	if secondId != firstId {
+		t.Errorf("second Start while first is running = %q, want the running job's id %q", secondId, firstId)
+	}
+
+	close(release)
+
+	deadline := time.After(time.Second)
+	for {
+		job, ok := jm.Status(firstId)
+		if ok && job.Status != JobRunning {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("timed out waiting for job to finish")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	thirdId, err := jm.Start("A.x.Foo.Bar")
+	if err != nil {
+		t.Fatalf("Start after completion: %v", err)
+	}
+	if thirdId == firstId {
+		t.Errorf("Start after the first job finished reused its id %q, want a new job", thirdId)
+	}
+}
@@ -0,0 +1,67 @@
+package fetcher
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var cursorBucket = []byte("cursors")
+
+// BoltCursorStore is a CursorStore backed by a local BoltDB file. It's the
+// default store for single-instance deployments that don't have a
+// Postgres database handy.
+type BoltCursorStore struct {
+	db *bolt.DB
+}
+
+// NewBoltCursorStore opens (creating if needed) a BoltDB file at path.
+func NewBoltCursorStore(path string) (*BoltCursorStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetcher: opening bolt cursor store: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(cursorBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("fetcher: initializing bolt cursor store: %w", err)
+	}
+
+	return &BoltCursorStore{db: db}, nil
+}
+
+func (s *BoltCursorStore) GetCursor(ctx context.Context, topic string) (uint64, bool, error) {
+	var height uint64
+	var ok bool
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(cursorBucket).Get([]byte(topic))
+		if value == nil {
+			return nil
+		}
+		height = binary.BigEndian.Uint64(value)
+		ok = true
+		return nil
+	})
+	return height, ok, err
+}
+
+func (s *BoltCursorStore) SetCursor(ctx context.Context, topic string, height uint64) error {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, height)
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(cursorBucket).Put([]byte(topic), value)
+	})
+}
+
+// Close releases the underlying BoltDB file handle.
+func (s *BoltCursorStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,62 @@
+package fetcher
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestBoltCursorStoreGetSetRoundTrip(t *testing.T) {
+	store, err := NewBoltCursorStore(filepath.Join(t.TempDir(), "cursors.db"))
+	if err != nil {
+		t.Fatalf("NewBoltCursorStore: %v", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+
+	if _, ok, err := store.GetCursor(ctx, "A.x.Foo.Bar"); err != nil || ok {
+		t.Fatalf("GetCursor on empty store: ok=%v, err=%v, want ok=false, err=nil", ok, err)
+	}
+
+	if err := store.SetCursor(ctx, "A.x.Foo.Bar", 42); err != nil {
+		t.Fatalf("SetCursor: %v", err)
+	}
+
+	height, ok, err := store.GetCursor(ctx, "A.x.Foo.Bar")
+	if err != nil {
+		t.Fatalf("GetCursor: %v", err)
+	}
+	if !ok || height != 42 {
+		t.Errorf("GetCursor = %d, %v, want 42, true", height, ok)
+	}
+
+	if err := store.SetCursor(ctx, "A.x.Foo.Bar", 99); err != nil {
+		t.Fatalf("SetCursor overwrite: %v", err)
+	}
+	if height, _, err := store.GetCursor(ctx, "A.x.Foo.Bar"); err != nil || height != 99 {
+		t.Errorf("GetCursor after overwrite = %d, err=%v, want 99, nil", height, err)
+	}
+}
+
+func TestBoltCursorStoreTracksTopicsIndependently(t *testing.T) {
+	store, err := NewBoltCursorStore(filepath.Join(t.TempDir(), "cursors.db"))
+	if err != nil {
+		t.Fatalf("NewBoltCursorStore: %v", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	if err := store.SetCursor(ctx, "topic-a", 1); err != nil {
+		t.Fatalf("SetCursor: %v", err)
+	}
+	if err := store.SetCursor(ctx, "topic-b", 2); err != nil {
+		t.Fatalf("SetCursor: %v", err)
+	}
+
+	heightA, _, _ := store.GetCursor(ctx, "topic-a")
+	heightB, _, _ := store.GetCursor(ctx, "topic-b")
+	if heightA != 1 || heightB != 2 {
+		t.Errorf("topic-a=%d, topic-b=%d, want 1, 2", heightA, heightB)
+	}
+}
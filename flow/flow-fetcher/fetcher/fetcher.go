@@ -0,0 +1,154 @@
+// File: fetcher.go
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow-go-sdk/client"
+	"google.golang.org/grpc"
+)
+
+// MaxRangeSize is the largest block range a Flow access node will answer
+// in a single GetEventsForHeightRange call.
+const MaxRangeSize = 250
+
+// EventFetcher is the subset of *client.Client the Fetcher needs, so it
+// can be faked out in tests. The trailing opts match *client.Client's
+// signature so that type satisfies this interface directly.
+type EventFetcher interface {
+	GetEventsForHeightRange(ctx context.Context, query client.EventRangeQuery, opts ...grpc.CallOption) ([]client.BlockEvents, error)
+}
+
+// LatestHeighter returns the current latest sealed block height.
+type LatestHeighter func(ctx context.Context) (uint64, error)
+
+// Fetcher fetches events for a topic across arbitrarily large height
+// ranges by splitting them into access-node-sized windows, retrying
+// transient access-node errors with exponential backoff, and persisting
+// progress to a CursorStore so a restart resumes where it left off.
+type Fetcher struct {
+	client       EventFetcher
+	cursors      CursorStore
+	latestHeight LatestHeighter
+
+	// RetryBaseDelay and RetryMaxAttempts tune the exponential backoff
+	// applied to each window on access-node error.
+	RetryBaseDelay   time.Duration
+	RetryMaxAttempts int
+
+	// OnWindow, if set, is called with each window's freshly fetched
+	// events before Resume persists that window's cursor - e.g. to
+	// decode and relay them. Resume only advances the cursor once
+	// OnWindow returns successfully, so a crash or error between
+	// fetching and relaying replays the window on the next Resume
+	// instead of silently skipping it. FetchRange never calls OnWindow:
+	// it doesn't track a cursor, so there's nothing to gate.
+	OnWindow func(ctx context.Context, topic string, events []client.BlockEvents) error
+}
+
+// NewFetcher builds a Fetcher backed by flowClient for events and cursors
+// for cursor persistence, with sensible retry defaults.
+func NewFetcher(flowClient EventFetcher, cursors CursorStore, latestHeight LatestHeighter) *Fetcher {
+	return &Fetcher{
+		client:           flowClient,
+		cursors:          cursors,
+		latestHeight:     latestHeight,
+		RetryBaseDelay:   500 * time.Millisecond,
+		RetryMaxAttempts: 5,
+	}
+}
+
+// FetchRange fetches all events matching topic in [startHeight, endHeight],
+// splitting the request into windows of at most MaxRangeSize blocks so
+// callers don't have to know about the access node's range cap.
+func (f *Fetcher) FetchRange(ctx context.Context, topic string, startHeight, endHeight uint64) ([]client.BlockEvents, error) {
+	return f.fetchWindows(ctx, topic, startHeight, endHeight, false)
+}
+
+// Resume fetches every event matching topic between the stored cursor
+// (exclusive) and the current latest sealed block, persisting the cursor
+// after each window so a crash mid-backfill only replays the current
+// window rather than the whole range.
+func (f *Fetcher) Resume(ctx context.Context, topic string) ([]client.BlockEvents, error) {
+	startHeight := uint64(0)
+	if cursor, ok, err := f.cursors.GetCursor(ctx, topic); err != nil {
+		return nil, err
+	} else if ok {
+		startHeight = cursor + 1
+	}
+
+	endHeight, err := f.latestHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if startHeight > endHeight {
+		return nil, nil
+	}
+
+	return f.fetchWindows(ctx, topic, startHeight, endHeight, true)
+}
+
+func (f *Fetcher) fetchWindows(ctx context.Context, topic string, startHeight, endHeight uint64, persistCursor bool) ([]client.BlockEvents, error) {
+	var all []client.BlockEvents
+
+	for windowStart := startHeight; windowStart <= endHeight; windowStart += MaxRangeSize {
+		windowEnd := windowStart + MaxRangeSize - 1
+		if windowEnd > endHeight {
+			windowEnd = endHeight
+		}
+
+		blockEvents, err := f.fetchWindowWithRetry(ctx, topic, windowStart, windowEnd)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, blockEvents...)
+
+		eventCount := 0
+		for _, be := range blockEvents {
+			eventCount += len(be.Events)
+		}
+		EventsProcessed.WithLabelValues(topic).Add(float64(eventCount))
+
+		if persistCursor {
+			if f.OnWindow != nil {
+				if err := f.OnWindow(ctx, topic, blockEvents); err != nil {
+					return all, err
+				}
+			}
+			if err := f.cursors.SetCursor(ctx, topic, windowEnd); err != nil {
+				return all, err
+			}
+		}
+	}
+
+	return all, nil
+}
+
+func (f *Fetcher) fetchWindowWithRetry(ctx context.Context, topic string, start, end uint64) ([]client.BlockEvents, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < f.RetryMaxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := f.RetryBaseDelay * time.Duration(1<<uint(attempt-1))
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		blockEvents, err := f.client.GetEventsForHeightRange(ctx, client.EventRangeQuery{
+			Type:        topic,
+			StartHeight: start,
+			EndHeight:   end,
+		})
+		if err == nil {
+			return blockEvents, nil
+		}
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("fetcher: fetching %s [%d,%d] after %d attempts: %w", topic, start, end, f.RetryMaxAttempts, lastErr)
+}
@@ -0,0 +1,157 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	flow "github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"google.golang.org/grpc"
+)
+
+type windowRecordingFetcher struct {
+	windows [][2]uint64
+	err     error
+}
+
+func (f *windowRecordingFetcher) GetEventsForHeightRange(ctx context.Context, query client.EventRangeQuery, opts ...grpc.CallOption) ([]client.BlockEvents, error) {
+	f.windows = append(f.windows, [2]uint64{query.StartHeight, query.EndHeight})
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []client.BlockEvents{{Height: query.EndHeight}}, nil
+}
+
+func newTestFetcher(client EventFetcher) *Fetcher {
+	f := NewFetcher(client, nil, nil)
+	f.RetryBaseDelay = time.Millisecond
+	return f
+}
+
+func TestFetchRangeSplitsIntoMaxRangeSizeWindows(t *testing.T) {
+	fake := &windowRecordingFetcher{}
+	f := newTestFetcher(fake)
+
+	if _, err := f.FetchRange(context.Background(), "A.x.Foo.Bar", 0, 600); err != nil {
+		t.Fatalf("FetchRange: %v", err)
+	}
+
+	want := [][2]uint64{{0, 249}, {250, 499}, {500, 600}}
+	if len(fake.windows) != len(want) {
+		t.Fatalf("windows = %v, want %v", fake.windows, want)
+	}
+	for i, w := range want {
+		if fake.windows[i] != w {
+			t.Errorf("window %d = %v, want %v", i, fake.windows[i], w)
+		}
+	}
+}
+
+func TestFetchRangeSingleWindowWhenWithinMaxRangeSize(t *testing.T) {
+	fake := &windowRecordingFetcher{}
+	f := newTestFetcher(fake)
+
+	if _, err := f.FetchRange(context.Background(), "A.x.Foo.Bar", 10, 20); err != nil {
+		t.Fatalf("FetchRange: %v", err)
+	}
+
+	if want := [][2]uint64{{10, 20}}; len(fake.windows) != 1 || fake.windows[0] != want[0] {
+		t.Fatalf("windows = %v, want %v", fake.windows, want)
+	}
+}
+
+type flakyFetcher struct {
+	failures int
+	calls    int
+}
+
+func (f *flakyFetcher) GetEventsForHeightRange(ctx context.Context, query client.EventRangeQuery, opts ...grpc.CallOption) ([]client.BlockEvents, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, errors.New("access node unavailable")
+	}
+	return []client.BlockEvents{{Height: query.EndHeight, Events: []flow.Event{{}}}}, nil
+}
+
+func TestFetchWindowWithRetrySucceedsAfterTransientErrors(t *testing.T) {
+	fake := &flakyFetcher{failures: 2}
+	f := newTestFetcher(fake)
+
+	blockEvents, err := f.FetchRange(context.Background(), "A.x.Foo.Bar", 0, 10)
+	if err != nil {
+		t.Fatalf("FetchRange: %v", err)
+	}
+	if fake.calls != 3 {
+		t.Errorf("calls = %d, want 3 (2 failures + 1 success)", fake.calls)
+	}
+	if len(blockEvents) != 1 {
+		t.Errorf("blockEvents = %v, want 1 entry", blockEvents)
+	}
+}
+
+func TestFetchWindowWithRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	fake := &flakyFetcher{failures: 100}
+	f := newTestFetcher(fake)
+
+	_, err := f.FetchRange(context.Background(), "A.x.Foo.Bar", 0, 10)
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries, got nil")
+	}
+	if fake.calls != f.RetryMaxAttempts {
+		t.Errorf("calls = %d, want %d (RetryMaxAttempts)", fake.calls, f.RetryMaxAttempts)
+	}
+}
+
+func TestResumeSkipsWhenCursorPastLatestHeight(t *testing.T) {
+	store := newMemCursorStore()
+	store.SetCursor(context.Background(), "A.x.Foo.Bar", 100)
+
+	fake := &windowRecordingFetcher{}
+	f := NewFetcher(fake, store, func(ctx context.Context) (uint64, error) { return 50, nil })
+
+	blockEvents, err := f.Resume(context.Background(), "A.x.Foo.Bar")
+	if err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+	if blockEvents != nil || len(fake.windows) != 0 {
+		t.Errorf("expected no fetch when cursor is already past the latest height, got windows %v", fake.windows)
+	}
+}
+
+func TestResumeAdvancesCursorPastLastWindow(t *testing.T) {
+	store := newMemCursorStore()
+	fake := &windowRecordingFetcher{}
+	f := NewFetcher(fake, store, func(ctx context.Context) (uint64, error) { return 10, nil })
+
+	if _, err := f.Resume(context.Background(), "A.x.Foo.Bar"); err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+
+	height, ok, err := store.GetCursor(context.Background(), "A.x.Foo.Bar")
+	if err != nil {
+		t.Fatalf("GetCursor: %v", err)
+	}
+	if !ok || height != 10 {
+		t.Errorf("cursor = %d, ok = %v, want 10, true", height, ok)
+	}
+}
+
+type memCursorStore struct {
+	cursors map[string]uint64
+}
+
+func newMemCursorStore() *memCursorStore {
+	return &memCursorStore{cursors: make(map[string]uint64)}
+}
+
+func (s *memCursorStore) GetCursor(ctx context.Context, topic string) (uint64, bool, error) {
+	height, ok := s.cursors[topic]
+	return height, ok, nil
+}
+
+func (s *memCursorStore) SetCursor(ctx context.Context, topic string, height uint64) error {
+	s.cursors[topic] = height
+	return nil
+}
@@ -0,0 +1,17 @@
+package fetcher
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// EventsProcessed counts events fetched per topic, across both backfill
+// runs and live catch-up.
+var EventsProcessed = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "flow_fetcher_events_processed_total",
+		Help: "Number of Flow events processed, labeled by topic.",
+	},
+	[]string{"topic"},
+)
+
+func init() {
+	prometheus.MustRegister(EventsProcessed)
+}
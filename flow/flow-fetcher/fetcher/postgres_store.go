@@ -0,0 +1,48 @@
+package fetcher
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// PostgresCursorStore is a CursorStore backed by Postgres, for multi-
+// instance deployments that need a shared cursor. It expects a table:
+//
+//	CREATE TABLE IF NOT EXISTS event_cursors (
+//	    topic  TEXT PRIMARY KEY,
+//	    height BIGINT NOT NULL
+//	);
+type PostgresCursorStore struct {
+	db *sql.DB
+}
+
+// NewPostgresCursorStore wraps an existing connection pool. Callers own
+// opening db (e.g. sql.Open("postgres", dsn)) and ensuring the
+// event_cursors table exists.
+func NewPostgresCursorStore(db *sql.DB) *PostgresCursorStore {
+	return &PostgresCursorStore{db: db}
+}
+
+func (s *PostgresCursorStore) GetCursor(ctx context.Context, topic string) (uint64, bool, error) {
+	var height uint64
+	err := s.db.QueryRowContext(ctx, `SELECT height FROM event_cursors WHERE topic = $1`, topic).Scan(&height)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("fetcher: reading cursor for %s: %w", topic, err)
+	}
+	return height, true, nil
+}
+
+func (s *PostgresCursorStore) SetCursor(ctx context.Context, topic string, height uint64) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO event_cursors (topic, height) VALUES ($1, $2)
+		ON CONFLICT (topic) DO UPDATE SET height = EXCLUDED.height
+	`, topic, height)
+	if err != nil {
+		return fmt.Errorf("fetcher: persisting cursor for %s: %w", topic, err)
+	}
+	return nil
+}
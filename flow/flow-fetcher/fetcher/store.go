@@ -0,0 +1,14 @@
+package fetcher
+
+import "context"
+
+// CursorStore persists the last fully processed sealed block height for
+// each event topic, so a restart resumes from where it left off instead of
+// replaying the whole chain.
+type CursorStore interface {
+	// GetCursor returns the last processed height for topic. ok is false
+	// if no cursor has been stored yet.
+	GetCursor(ctx context.Context, topic string) (height uint64, ok bool, err error)
+	// SetCursor persists height as the last processed height for topic.
+	SetCursor(ctx context.Context, topic string, height uint64) error
+}
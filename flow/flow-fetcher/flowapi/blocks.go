@@ -1,14 +1,20 @@
 // File: blocks.go
-package main
+package flowapi
 
 import (
 	"context"
 	"fmt"
 
 	"github.com/onflow/flow-go-sdk"
-	"github.com/onflow/flow-go-sdk/client"
 )
 
+// BlockInfo identifies a block to look up by either id or height. If both
+// are zero-valued, GetBlock returns the latest sealed block.
+type BlockInfo struct {
+	Id     string `schema:"id"`
+	Height uint64 `schema:"height"`
+}
+
 type Block struct {
 	BlockId       string `json:"blockId"`
 	ParentBlockId string `json:"parentBlockId"`
@@ -16,52 +22,48 @@ type Block struct {
 	Timestamp     string `json:"timestamp"`
 }
 
-func getLatestBlock(flowClient *client.Client) (Block, error) {
+// GetLatestBlock returns the latest sealed Flow block.
+func (s *Service) GetLatestBlock(ctx context.Context) (Block, error) {
 	// Fetching only sealed blocks here
 	isSealed := true
-	latestBlock, err := flowClient.GetLatestBlock(context.Background(), isSealed)
+	latestBlock, err := s.FlowClient.GetLatestBlock(ctx, isSealed)
 	if err != nil {
 		return Block{}, err
 	}
 
 	fmt.Println("Latest block: ", latestBlock)
 
-	var blockRes = Block{
+	return Block{
 		BlockId:       latestBlock.ID.String(),
 		ParentBlockId: latestBlock.ParentID.String(),
 		Height:        latestBlock.Height,
 		Timestamp:     latestBlock.Timestamp.String(),
-	}
-
-	return blockRes, nil
+	}, nil
 }
 
-func getBlock(flowClient *client.Client, blockInfo FlowBlockInfo) (Block, error) {
-	// If height and id of block are no set, return the latest block
+// GetBlock resolves blockInfo to a Block, by id if set, else by height, or
+// the latest sealed block if neither is set.
+func (s *Service) GetBlock(ctx context.Context, blockInfo BlockInfo) (Block, error) {
 	if blockInfo.Id == "" && blockInfo.Height == 0 {
-		return getLatestBlock(flowClient)
+		return s.GetLatestBlock(ctx)
 	}
 
 	block, err := func() (*flow.Block, error) {
 		if blockInfo.Height == 0 {
-			return flowClient.GetBlockByID(context.Background(), flow.HexToID(blockInfo.Id))
-		} else {
-			return flowClient.GetBlockByHeight(context.Background(), blockInfo.Height)
+			return s.FlowClient.GetBlockByID(ctx, flow.HexToID(blockInfo.Id))
 		}
+		return s.FlowClient.GetBlockByHeight(ctx, blockInfo.Height)
 	}()
-
 	if err != nil {
 		return Block{}, err
 	}
 
 	fmt.Printf("Block %+v for data %+v:\n", block, blockInfo)
 
-	blockRes := Block{
+	return Block{
 		BlockId:       block.ID.String(),
 		ParentBlockId: block.ParentID.String(),
 		Height:        block.Height,
 		Timestamp:     block.Timestamp.String(),
-	}
-
-	return blockRes, nil
+	}, nil
 }
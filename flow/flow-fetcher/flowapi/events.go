@@ -0,0 +1,151 @@
+// File: events.go
+package flowapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/toni/flow-fetcher/relay"
+	"github.com/toni/flow-fetcher/starport"
+)
+
+// EventsInfo describes an /events request: the Cadence event type to
+// fetch and the inclusive block height range to fetch it over.
+type EventsInfo struct {
+	Topic       string `schema:"topic" validate:"required"`
+	StartHeight uint64 `schema:"start_height"`
+	EndHeight   uint64 `schema:"end_height" validate:"gtefield=StartHeight"`
+
+	// AmountFormat controls how starport.UFix64 fields (e.g. a Lock
+	// event's amount) are rendered: "decimal" (default) for the canonical
+	// "123.45000000" string, "raw" for the underlying scaled uint64, or
+	// "float" for a float64, which clients that haven't updated to the
+	// decimal string yet can keep relying on.
+	AmountFormat string `schema:"amount_format" validate:"omitempty,oneof=raw decimal float"`
+}
+
+// FlowEvent is a single decoded Cadence event emitted on Flow.
+type FlowEvent struct {
+	BlockId          string          `json:"blockId"`
+	BlockHeight      uint64          `json:"blockHeight"`
+	TransactionId    string          `json:"transactionId"`
+	TransactionIndex int             `json:"transactionIndex"`
+	EventIndex       int             `json:"eventIndex"`
+	Topic            string          `json:"topic"`
+	Data             json.RawMessage `json:"data"`
+}
+
+// GetEvents fetches and decodes every event matching eventsInfo.Topic in
+// [StartHeight, EndHeight]. The range is chunked into access-node-sized
+// windows by s.Fetcher, so a request spanning an arbitrarily large range
+// (more than fetcher.MaxRangeSize blocks) still succeeds.
+func (s *Service) GetEvents(ctx context.Context, eventsInfo EventsInfo) ([]FlowEvent, error) {
+	blockEvents, err := s.Fetcher.FetchRange(ctx, eventsInfo.Topic, eventsInfo.StartHeight, eventsInfo.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+	return s.DecodeEvents(blockEvents, eventsInfo.AmountFormat)
+}
+
+// DecodeEvents decodes every event in blockEvents, relaying any event on
+// s.LockTopic if a Relayer is configured, and renders amounts per format
+// (see EventsInfo.AmountFormat). It's the shared decode path for both the
+// synchronous GetEvents request and the background backfill/resume path,
+// which wires it in as a Fetcher.OnWindow callback so backfilled Lock
+// events reach the relay bridge too, not just the persisted cursor.
+func (s *Service) DecodeEvents(blockEvents []client.BlockEvents, format string) ([]FlowEvent, error) {
+	var events []FlowEvent
+
+	for _, blockEvent := range blockEvents {
+		for _, evt := range blockEvent.Events {
+			fmt.Printf("transactionID: %s, block height: %d\n",
+				evt.TransactionID.String(), blockEvent.Height)
+
+			data, err := s.Registry.Decode(evt.Value)
+			if err != nil {
+				return nil, fmt.Errorf("flowapi: decoding %s: %w", evt.Type, err)
+			}
+
+			if s.Relayer != nil && evt.Type == s.LockTopic {
+				// Relaying is a side channel to a downstream signer, not
+				// part of this response; don't hold up the caller on it,
+				// and log rather than fail the request if it errors. It
+				// uses the un-reformatted data so it always sees the
+				// exact UFix64 amount regardless of format.
+				go s.relayLockEvent(blockEvent.BlockID.String(), blockEvent.Height, evt, data)
+			}
+
+			rawData, err := json.Marshal(formatAmounts(data, format))
+			if err != nil {
+				return nil, err
+			}
+
+			events = append(events, FlowEvent{
+				BlockId:          blockEvent.BlockID.String(),
+				BlockHeight:      blockEvent.Height,
+				TransactionId:    evt.TransactionID.String(),
+				TransactionIndex: evt.TransactionIndex,
+				EventIndex:       evt.EventIndex,
+				Topic:            evt.Type,
+				Data:             rawData,
+			})
+		}
+	}
+
+	return events, nil
+}
+
+// relayLockEvent builds a relay.LockNotice from a decoded Lock event and
+// hands it to the configured Relayer.
+func (s *Service) relayLockEvent(blockId string, blockHeight uint64, evt flow.Event, data map[string]interface{}) {
+	asset, _ := data["asset"].(string)
+	recipient, _ := data["recipient"].(string)
+	amount, _ := data["amount"].(starport.UFix64)
+
+	notice := relay.LockNotice{
+		ChainId:     "flow",
+		Asset:       asset,
+		Recipient:   recipient,
+		Amount:      amount.Raw(),
+		BlockId:     blockId,
+		BlockHeight: blockHeight,
+		TxId:        evt.TransactionID.String(),
+		EventIndex:  evt.EventIndex,
+	}
+
+	if err := s.Relayer.Relay(context.Background(), notice); err != nil {
+		fmt.Println("relay: failed to relay lock event:", err)
+	}
+}
+
+// formatAmounts rewrites any starport.UFix64 field in data according to
+// format ("raw", "decimal", or "" for the default decimal string), for
+// clients of the /events endpoint that want the older uint64
+// representation or a float instead.
+func formatAmounts(data map[string]interface{}, format string) map[string]interface{} {
+	if format == "" || format == "decimal" {
+		return data
+	}
+
+	formatted := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		amount, ok := value.(starport.UFix64)
+		if !ok {
+			formatted[key] = value
+			continue
+		}
+
+		switch format {
+		case "raw":
+			formatted[key] = amount.Raw()
+		case "float":
+			formatted[key] = amount.Float64()
+		default:
+			formatted[key] = amount
+		}
+	}
+	return formatted
+}
@@ -0,0 +1,48 @@
+// Package flowapi implements the flow-fetcher's Flow access-node
+// integration: fetching and decoding events, resolving blocks, and
+// fanning out live event streams. It knows nothing about HTTP; the
+// server package binds requests onto it.
+package flowapi
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/toni/flow-fetcher/fetcher"
+	"github.com/toni/flow-fetcher/relay"
+	"github.com/toni/flow-fetcher/starport"
+)
+
+// Service is the Flow access-node integration shared by every endpoint.
+type Service struct {
+	FlowClient *client.Client
+	Registry   *starport.Registry
+
+	// Fetcher chunks event ranges into access-node-sized windows so
+	// GetEvents (and anything polling it, like the /events stream) can
+	// serve arbitrarily large [StartHeight, EndHeight] requests.
+	Fetcher *fetcher.Fetcher
+
+	// Relayer and LockTopic are optional. When both are set, every
+	// decoded event on LockTopic is also forwarded to Relayer as a signed
+	// LockNotice, in addition to being returned to the HTTP caller.
+	Relayer   *relay.Relayer
+	LockTopic string
+}
+
+// NewService wraps flowClient, registry, and eventFetcher for use by the
+// server's handlers.
+func NewService(flowClient *client.Client, registry *starport.Registry, eventFetcher *fetcher.Fetcher) *Service {
+	if flowClient == nil {
+		panic("Flow client is not set")
+	}
+	if eventFetcher == nil {
+		panic("event fetcher is not set")
+	}
+	return &Service{FlowClient: flowClient, Registry: registry, Fetcher: eventFetcher}
+}
+
+// Ping checks connectivity to the Flow access node, for the /readyz probe.
+func (s *Service) Ping(ctx context.Context) error {
+	return s.FlowClient.Ping(ctx)
+}
@@ -0,0 +1,171 @@
+// File: stream.go
+package flowapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const streamPollInterval = 2 * time.Second
+
+// Subscriber receives FlowEvents for a single topic from the shared
+// poller behind a StreamManager.
+type Subscriber struct {
+	Events chan FlowEvent
+}
+
+// eventFetcher is the subset of *Service a topicPoller needs, so it can
+// be faked out in tests instead of hitting a real Flow access node.
+type eventFetcher interface {
+	GetLatestBlock(ctx context.Context) (Block, error)
+	GetEvents(ctx context.Context, eventsInfo EventsInfo) ([]FlowEvent, error)
+}
+
+// topicPoller polls the Flow access node for a single topic on behalf of
+// every subscriber currently watching it, so N websocket/long-poll
+// clients on the same topic don't each spawn their own poller against the
+// access node.
+type topicPoller struct {
+	topic   string
+	service eventFetcher
+
+	mu         sync.Mutex
+	nextHeight uint64
+	subs       map[*Subscriber]struct{}
+	done       chan struct{}
+}
+
+// StreamManager fans out Flow events to websocket/long-poll subscribers,
+// sharing one poller per topic across all connected clients.
+type StreamManager struct {
+	service eventFetcher
+
+	mu      sync.Mutex
+	pollers map[string]*topicPoller
+}
+
+// NewStreamManager returns a StreamManager that polls via service.
+func NewStreamManager(service *Service) *StreamManager {
+	return &StreamManager{
+		service: service,
+		pollers: make(map[string]*topicPoller),
+	}
+}
+
+// Subscribe registers a new subscriber for topic, starting a poller for
+// it if one isn't already running, and returns an unsubscribe func that
+// must be called (typically via defer) once the caller is done.
+func (sm *StreamManager) Subscribe(topic string, startHeight uint64) (*Subscriber, func()) {
+	sm.mu.Lock()
+	poller, ok := sm.pollers[topic]
+	if !ok {
+		poller = &topicPoller{
+			topic:      topic,
+			service:    sm.service,
+			nextHeight: startHeight,
+			subs:       make(map[*Subscriber]struct{}),
+			done:       make(chan struct{}),
+		}
+		sm.pollers[topic] = poller
+		go poller.run()
+	}
+	sm.mu.Unlock()
+
+	poller.mu.Lock()
+	if startHeight < poller.nextHeight {
+		// a later subscriber asked to start earlier than we've polled from;
+		// rewind so it doesn't miss events the poller already passed.
+		poller.nextHeight = startHeight
+	}
+	sub := &Subscriber{Events: make(chan FlowEvent, 32)}
+	poller.subs[sub] = struct{}{}
+	poller.mu.Unlock()
+
+	unsubscribe := func() {
+		poller.mu.Lock()
+		delete(poller.subs, sub)
+		empty := len(poller.subs) == 0
+		poller.mu.Unlock()
+
+		if !empty {
+			return
+		}
+		sm.mu.Lock()
+		if sm.pollers[topic] == poller {
+			delete(sm.pollers, topic)
+		}
+		sm.mu.Unlock()
+		close(poller.done)
+	}
+
+	return sub, unsubscribe
+}
+
+func (p *topicPoller) run() {
+	ticker := time.NewTicker(streamPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			p.poll()
+		}
+	}
+}
+
+// poll fetches every event on p.topic since the last poll and fans it out
+// to every current subscriber. [start, latestBlock.Height] can span an
+// arbitrarily large number of blocks - e.g. right after a client
+// reconnects with a start_height far behind the current tip - since
+// GetEvents chunks the range itself; poll doesn't need to bound it. On
+// error, nextHeight is left unadvanced so the same window is retried on
+// the next tick.
+func (p *topicPoller) poll() {
+	ctx := context.Background()
+
+	latestBlock, err := p.service.GetLatestBlock(ctx)
+	if err != nil {
+		fmt.Println("stream poller: failed to fetch latest block:", err)
+		return
+	}
+
+	p.mu.Lock()
+	start := p.nextHeight
+	p.mu.Unlock()
+
+	if latestBlock.Height < start {
+		return
+	}
+
+	newEvents, err := p.service.GetEvents(ctx, EventsInfo{
+		Topic:       p.topic,
+		StartHeight: start,
+		EndHeight:   latestBlock.Height,
+	})
+	if err != nil {
+		fmt.Printf("stream poller: topic %s: failed to fetch events for [%d,%d]: %v\n", p.topic, start, latestBlock.Height, err)
+		return
+	}
+
+	p.mu.Lock()
+	p.nextHeight = latestBlock.Height + 1
+	subs := make([]*Subscriber, 0, len(p.subs))
+	for sub := range p.subs {
+		subs = append(subs, sub)
+	}
+	p.mu.Unlock()
+
+	for _, event := range newEvents {
+		for _, sub := range subs {
+			select {
+			case sub.Events <- event:
+			default:
+				// subscriber is falling behind; drop rather than block the poller.
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package flowapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakeEventFetcher is a faked-out eventFetcher so topicPoller/StreamManager
+// can be driven deterministically in tests, without a real Flow access
+// node.
+type fakeEventFetcher struct {
+	latestHeight uint64
+	events       []FlowEvent
+	err          error
+
+	// calls records the [start, end] window of each GetEvents call, in
+	// order.
+	calls [][2]uint64
+}
+
+func (f *fakeEventFetcher) GetLatestBlock(ctx context.Context) (Block, error) {
+	return Block{Height: f.latestHeight}, nil
+}
+
+func (f *fakeEventFetcher) GetEvents(ctx context.Context, eventsInfo EventsInfo) ([]FlowEvent, error) {
+	f.calls = append(f.calls, [2]uint64{eventsInfo.StartHeight, eventsInfo.EndHeight})
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.events, nil
+}
+
+func newTestPoller(fake eventFetcher, startHeight uint64) *topicPoller {
+	return &topicPoller{
+		topic:      "A.x.Starport.Lock",
+		service:    fake,
+		nextHeight: startHeight,
+		subs:       make(map[*Subscriber]struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+func TestTopicPollerAdvancesNextHeightOnSuccess(t *testing.T) {
+	fake := &fakeEventFetcher{latestHeight: 600}
+	p := newTestPoller(fake, 10)
+
+	p.poll()
+
+	if len(fake.calls) != 1 || fake.calls[0] != [2]uint64{10, 600} {
+		t.Fatalf("calls = %v, want one call for [10,600] - a >250 block window, which is no longer expected to fail now that GetEvents chunks internally", fake.calls)
+	}
+	if p.nextHeight != 601 {
+		t.Errorf("nextHeight = %d, want 601", p.nextHeight)
+	}
+}
+
+func TestTopicPollerSkipsWhenNothingNew(t *testing.T) {
+	fake := &fakeEventFetcher{latestHeight: 5}
+	p := newTestPoller(fake, 10)
+
+	p.poll()
+
+	if len(fake.calls) != 0 {
+		t.Errorf("expected no GetEvents call when latest height is behind nextHeight, got %v", fake.calls)
+	}
+	if p.nextHeight != 10 {
+		t.Errorf("nextHeight = %d, want unchanged 10", p.nextHeight)
+	}
+}
+
+func TestTopicPollerDoesNotAdvanceOnError(t *testing.T) {
+	fake := &fakeEventFetcher{latestHeight: 600, err: errors.New("access node unavailable")}
+	p := newTestPoller(fake, 10)
+
+	p.poll()
+
+	if p.nextHeight != 10 {
+		t.Errorf("nextHeight = %d after a failed poll, want unchanged 10 so the window is retried", p.nextHeight)
+	}
+}
+
+func TestTopicPollerFansOutToAllSubscribers(t *testing.T) {
+	want := FlowEvent{Topic: "A.x.Starport.Lock", BlockHeight: 15}
+	fake := &fakeEventFetcher{latestHeight: 20, events: []FlowEvent{want}}
+	p := newTestPoller(fake, 10)
+
+	subA := &Subscriber{Events: make(chan FlowEvent, 1)}
+	subB := &Subscriber{Events: make(chan FlowEvent, 1)}
+	p.subs[subA] = struct{}{}
+	p.subs[subB] = struct{}{}
+
+	p.poll()
+
+	for name, sub := range map[string]*Subscriber{"subA": subA, "subB": subB} {
+		select {
+		case got := <-sub.Events:
+			if got.Topic != want.Topic || got.BlockHeight != want.BlockHeight {
+				t.Errorf("%s got %+v, want %+v", name, got, want)
+			}
+		default:
+			t.Errorf("%s received nothing", name)
+		}
+	}
+}
+
+func TestTopicPollerDropsEventsPastSubscriberBuffer(t *testing.T) {
+	fake := &fakeEventFetcher{latestHeight: 20, events: []FlowEvent{{BlockHeight: 1}, {BlockHeight: 2}}}
+	p := newTestPoller(fake, 10)
+
+	sub := &Subscriber{Events: make(chan FlowEvent, 1)}
+	p.subs[sub] = struct{}{}
+
+	// Must not block even though there are more events than buffer space.
+	p.poll()
+
+	if len(sub.Events) != 1 {
+		t.Errorf("buffered events = %d, want 1 (buffer capacity)", len(sub.Events))
+	}
+}
+
+func TestStreamManagerSharesPollerAcrossSubscribers(t *testing.T) {
+	sm := NewStreamManager(&Service{})
+
+	_, unsub1 := sm.Subscribe("A.x.Starport.Lock", 10)
+	_, unsub2 := sm.Subscribe("A.x.Starport.Lock", 5)
+
+	sm.mu.Lock()
+	pollerCount := len(sm.pollers)
+	poller := sm.pollers["A.x.Starport.Lock"]
+	sm.mu.Unlock()
+
+	if pollerCount != 1 {
+		t.Fatalf("pollers = %d, want 1 shared poller for both subscribers", pollerCount)
+	}
+
+	poller.mu.Lock()
+	nextHeight := poller.nextHeight
+	subCount := len(poller.subs)
+	poller.mu.Unlock()
+
+	if nextHeight != 5 {
+		t.Errorf("nextHeight = %d, want 5 (rewound to the earlier subscriber's start)", nextHeight)
+	}
+	if subCount != 2 {
+		t.Errorf("subs = %d, want 2", subCount)
+	}
+
+	unsub1()
+	poller.mu.Lock()
+	subCount = len(poller.subs)
+	poller.mu.Unlock()
+	if subCount != 1 {
+		t.Errorf("subs after first unsubscribe = %d, want 1", subCount)
+	}
+
+	unsub2()
+	sm.mu.Lock()
+	_, stillRegistered := sm.pollers["A.x.Starport.Lock"]
+	sm.mu.Unlock()
+	if stillRegistered {
+		t.Error("poller still registered after its last subscriber unsubscribed")
+	}
+}
@@ -2,156 +2,22 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"crypto/ed25519"
+	"encoding/hex"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 
 	"google.golang.org/grpc"
 
 	"github.com/onflow/flow-go-sdk/client"
+	"github.com/toni/flow-fetcher/fetcher"
+	"github.com/toni/flow-fetcher/flowapi"
+	"github.com/toni/flow-fetcher/relay"
+	"github.com/toni/flow-fetcher/server"
+	"github.com/toni/flow-fetcher/starport"
 )
 
-type FlowEventsInfo struct {
-	Topic       string
-	StartHeight uint64
-	EndHeight   uint64
-}
-
-type FlowBlockInfo struct {
-	Id     string
-	Height uint64
-}
-
-func EventsHandler(flowClient *client.Client) func(http.ResponseWriter, *http.Request) {
-	if flowClient == nil {
-		panic("Flow client is not set")
-	}
-
-	return func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/events" {
-			http.Error(w, "404 not found.", http.StatusNotFound)
-			return
-		}
-
-		if r.Method != "GET" {
-			http.Error(w, "Method is not supported.", http.StatusNotFound)
-			return
-		}
-
-		// Try to decode the request body into FlowEventsInfo the struct.
-		var eventsInfo FlowEventsInfo
-		err := decodeJSONBody(w, r, &eventsInfo)
-		if err != nil {
-			var mr *malformedRequest
-			if errors.As(err, &mr) {
-				http.Error(w, mr.msg, mr.status)
-			} else {
-				log.Println(err.Error())
-				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			}
-			return
-		}
-
-		// Fetch Lock events
-		events, err := getLockEvents(flowClient, eventsInfo)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		js, err := json.Marshal(events)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(js)
-	}
-}
-
-func BlockHandler(flowClient *client.Client) func(http.ResponseWriter, *http.Request) {
-	if flowClient == nil {
-		panic("Flow client is not set")
-	}
-
-	return func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/block" {
-			http.Error(w, "404 not found.", http.StatusNotFound)
-			return
-		}
-
-		if r.Method != "GET" {
-			http.Error(w, "Method is not supported.", http.StatusNotFound)
-			return
-		}
-
-		// Try to decode the request body into FlowEventsInfo the struct.
-		var blockInfo FlowBlockInfo
-		err := decodeJSONBody(w, r, &blockInfo)
-		if err != nil {
-			var mr *malformedRequest
-			if errors.As(err, &mr) {
-				http.Error(w, mr.msg, mr.status)
-			} else {
-				log.Println(err.Error())
-				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			}
-			return
-		}
-
-		// Fetch Block info
-		events, err := getBlock(flowClient, blockInfo)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		js, err := json.Marshal(events)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(js)
-	}
-}
-
-func LatestBlockHandler(flowClient *client.Client) func(http.ResponseWriter, *http.Request) {
-	if flowClient == nil {
-		panic("Flow client is not set")
-	}
-
-	return func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/latest_block" {
-			http.Error(w, "404 not found.", http.StatusNotFound)
-			return
-		}
-
-		if r.Method != "GET" {
-			http.Error(w, "Method is not supported.", http.StatusNotFound)
-			return
-		}
-
-		// Fetch Latest sealed Flow block
-		latestBlock, err := getLatestBlock(flowClient)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		js, err := json.Marshal(latestBlock)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(js)
-	}
-}
-
 // getEnv get key environment variable if exist otherwise return defalutValue
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
@@ -167,6 +33,24 @@ func handleErr(err error) {
 	}
 }
 
+// parseRelayerKey decodes FLOW_RELAYER_KEY, a hex-encoded Ed25519 seed or
+// private key, into the key used to sign outgoing LockNotices.
+func parseRelayerKey(hexKey string) (ed25519.PrivateKey, error) {
+	seed, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("FLOW_RELAYER_KEY: %w", err)
+	}
+
+	switch len(seed) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(seed), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(seed), nil
+	default:
+		return nil, fmt.Errorf("FLOW_RELAYER_KEY: expected %d or %d bytes, got %d", ed25519.SeedSize, ed25519.PrivateKeySize, len(seed))
+	}
+}
+
 // 34944396, 34944396, "A.c8873a26b148ed14.Starport.Lock"
 func main() {
 	// connect to Flow testnet
@@ -176,19 +60,71 @@ func main() {
 	err = flowClient.Ping(context.Background())
 	handleErr(err)
 
-	// Add `Lock` and other Flow events handler
-	http.HandleFunc("/events", EventsHandler(flowClient))
+	// Register Cadence event decoders
+	starportContractAddress := getEnv("STARPORT_CONTRACT_ADDRESS", "c8873a26b148ed14")
+	registry := starport.NewRegistry()
+	starport.RegisterDefaults(registry, starportContractAddress)
 
-	// Add block handler
-	http.HandleFunc("/block", BlockHandler(flowClient))
+	// Set up the chunked, cursor-resuming backfill fetcher and its async
+	// job endpoint. latestHeight talks to flowClient directly (rather
+	// than through the Service below) since the Service isn't built yet.
+	cursorStore, err := fetcher.NewBoltCursorStore(getEnv("FETCHER_CURSOR_DB", "flow-fetcher-cursors.db"))
+	handleErr(err)
+
+	latestHeight := func(ctx context.Context) (uint64, error) {
+		block, err := flowClient.GetLatestBlock(ctx, true)
+		if err != nil {
+			return 0, err
+		}
+		return block.Height, nil
+	}
+	backfillFetcher := fetcher.NewFetcher(flowClient, cursorStore, latestHeight)
+	backfillJobs := fetcher.NewJobManager(backfillFetcher)
+
+	service := flowapi.NewService(flowClient, registry, backfillFetcher)
+	streams := flowapi.NewStreamManager(service)
+
+	// Every window backfillFetcher fetches - via /events, /backfill, or
+	// the startup resume below - gets decoded and, if it's on LockTopic,
+	// relayed, before its cursor is persisted. Without this, backfilled
+	// Lock events were cursor-acknowledged without ever reaching the
+	// relay bridge.
+	backfillFetcher.OnWindow = func(ctx context.Context, topic string, blockEvents []client.BlockEvents) error {
+		_, err := service.DecodeEvents(blockEvents, "")
+		return err
+	}
+
+	// On startup, resume the Starport Lock topic from its stored cursor up
+	// to the current latest sealed block. This goes through backfillJobs,
+	// not backfillFetcher.Resume directly, so it shares the same
+	// per-topic dedup as a client's GET /backfill?topic=... - otherwise
+	// the two could run Resume concurrently and interleave cursor writes.
+	starportLockTopic := fmt.Sprintf("A.%s.Starport.Lock", starportContractAddress)
+	if _, err := backfillJobs.Start(starportLockTopic); err != nil {
+		log.Println("startup backfill resume failed:", err)
+	}
+
+	// Bridge Lock events to a downstream signer/relayer, if configured.
+	if relayerKeyHex := os.Getenv("FLOW_RELAYER_KEY"); relayerKeyHex != "" {
+		relayerKey, err := parseRelayerKey(relayerKeyHex)
+		handleErr(err)
+
+		replayStore, err := relay.NewBoltReplayStore(getEnv("RELAY_REPLAY_DB", "flow-relay-replay.db"))
+		handleErr(err)
+
+		sink, err := relay.NewLogSink(getEnv("RELAY_LOG_SINK_PATH", "flow-relay-notices.log"))
+		handleErr(err)
+
+		service.Relayer = relay.NewRelayer(relayerKey, sink, replayStore)
+		service.LockTopic = starportLockTopic
+	}
 
-	// Add latest block handler
-	http.HandleFunc("/latest_block", LatestBlockHandler(flowClient))
+	srv := server.New(service, streams, backfillJobs)
 
 	// Start the server
 	flowServerPort := getEnv("FLOW_SERVER_PORT", "8089")
 	fmt.Printf("Starting server at port %s\n", flowServerPort)
-	if err := http.ListenAndServe(":"+flowServerPort, nil); err != nil {
+	if err := srv.ListenAndServe(":" + flowServerPort); err != nil {
 		log.Fatal(err)
 	}
 }
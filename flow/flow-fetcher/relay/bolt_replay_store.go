@@ -0,0 +1,54 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var replayBucket = []byte("delivered_notices")
+
+// BoltReplayStore is a ReplayStore backed by a local BoltDB file.
+type BoltReplayStore struct {
+	db *bolt.DB
+}
+
+// NewBoltReplayStore opens (creating if needed) a BoltDB file at path.
+func NewBoltReplayStore(path string) (*BoltReplayStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("relay: opening bolt replay store: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(replayBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("relay: initializing bolt replay store: %w", err)
+	}
+
+	return &BoltReplayStore{db: db}, nil
+}
+
+func (s *BoltReplayStore) Seen(ctx context.Context, key Key) (bool, error) {
+	var seen bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		seen = tx.Bucket(replayBucket).Get([]byte(key.String())) != nil
+		return nil
+	})
+	return seen, err
+}
+
+func (s *BoltReplayStore) MarkDelivered(ctx context.Context, key Key) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(replayBucket).Put([]byte(key.String()), []byte{1})
+	})
+}
+
+// Close releases the underlying BoltDB file handle.
+func (s *BoltReplayStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,58 @@
+package relay
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestBoltReplayStoreSeenRoundTrip(t *testing.T) {
+	store, err := NewBoltReplayStore(filepath.Join(t.TempDir(), "replay.db"))
+	if err != nil {
+		t.Fatalf("NewBoltReplayStore: %v", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	key := Key{BlockId: "b1", TransactionId: "t1", EventIndex: 0}
+
+	seen, err := store.Seen(ctx, key)
+	if err != nil {
+		t.Fatalf("Seen: %v", err)
+	}
+	if seen {
+		t.Fatal("Seen = true before MarkDelivered, want false")
+	}
+
+	if err := store.MarkDelivered(ctx, key); err != nil {
+		t.Fatalf("MarkDelivered: %v", err)
+	}
+
+	seen, err = store.Seen(ctx, key)
+	if err != nil {
+		t.Fatalf("Seen: %v", err)
+	}
+	if !seen {
+		t.Error("Seen = false after MarkDelivered, want true")
+	}
+}
+
+func TestBoltReplayStoreDistinctKeysIndependent(t *testing.T) {
+	store, err := NewBoltReplayStore(filepath.Join(t.TempDir(), "replay.db"))
+	if err != nil {
+		t.Fatalf("NewBoltReplayStore: %v", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	delivered := Key{BlockId: "b1", TransactionId: "t1", EventIndex: 0}
+	other := Key{BlockId: "b1", TransactionId: "t1", EventIndex: 1}
+
+	if err := store.MarkDelivered(ctx, delivered); err != nil {
+		t.Fatalf("MarkDelivered: %v", err)
+	}
+
+	if seen, err := store.Seen(ctx, other); err != nil || seen {
+		t.Errorf("Seen(other) = %v, err=%v, want false, nil", seen, err)
+	}
+}
@@ -0,0 +1,44 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// LogSink is a Sink that appends each notice as a line of JSON to a local
+// file. It's the simplest Sink: useful for local development, or as a
+// durable audit trail running alongside a real Sink.
+type LogSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewLogSink opens (creating/appending to) the log file at path.
+func NewLogSink(path string) (*LogSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("relay: opening log sink file: %w", err)
+	}
+	return &LogSink{file: file}, nil
+}
+
+func (s *LogSink) Send(ctx context.Context, notice SignedLockNotice) error {
+	line, err := json.Marshal(notice)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(line)
+	return err
+}
+
+// Close releases the underlying file handle.
+func (s *LogSink) Close() error {
+	return s.file.Close()
+}
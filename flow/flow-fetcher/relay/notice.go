@@ -0,0 +1,36 @@
+// Package relay bridges decoded Flow Lock events onto a downstream
+// signer/relayer: it wraps each one in a canonical, signed envelope and
+// delivers it at-least-once through a pluggable Sink, deduping on replay.
+package relay
+
+import "fmt"
+
+// LockNotice is the canonical cross-chain envelope for a Flow Lock event,
+// handed to a downstream signer/relayer.
+type LockNotice struct {
+	ChainId     string `json:"chainId"`
+	Asset       string `json:"asset"`
+	Recipient   string `json:"recipient"`
+	Amount      uint64 `json:"amount"`
+	BlockId     string `json:"blockId"`
+	BlockHeight uint64 `json:"blockHeight"`
+	TxId        string `json:"txId"`
+	EventIndex  int    `json:"eventIndex"`
+}
+
+// Key uniquely identifies a LockNotice for idempotent delivery and replay
+// protection.
+type Key struct {
+	BlockId       string
+	TransactionId string
+	EventIndex    int
+}
+
+// Key returns n's idempotency key.
+func (n LockNotice) Key() Key {
+	return Key{BlockId: n.BlockId, TransactionId: n.TxId, EventIndex: n.EventIndex}
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s:%s:%d", k.BlockId, k.TransactionId, k.EventIndex)
+}
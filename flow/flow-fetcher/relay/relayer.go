@@ -0,0 +1,49 @@
+// File: relayer.go
+package relay
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+)
+
+// Relayer turns decoded Lock events into signed, idempotent LockNotices
+// and delivers them to a Sink, skipping any it has already delivered.
+type Relayer struct {
+	key    ed25519.PrivateKey
+	sink   Sink
+	replay ReplayStore
+}
+
+// NewRelayer builds a Relayer that signs with key and delivers through
+// sink, consulting replay for idempotency.
+func NewRelayer(key ed25519.PrivateKey, sink Sink, replay ReplayStore) *Relayer {
+	return &Relayer{key: key, sink: sink, replay: replay}
+}
+
+// Relay signs and delivers notice unless its key has already been
+// delivered, then records it as delivered on success. Safe to call
+// concurrently and to retry on error - it's a no-op once delivery
+// succeeds.
+func (r *Relayer) Relay(ctx context.Context, notice LockNotice) error {
+	key := notice.Key()
+
+	seen, err := r.replay.Seen(ctx, key)
+	if err != nil {
+		return fmt.Errorf("relay: checking replay store: %w", err)
+	}
+	if seen {
+		return nil
+	}
+
+	signed, err := Sign(r.key, notice)
+	if err != nil {
+		return fmt.Errorf("relay: signing notice: %w", err)
+	}
+
+	if err := r.sink.Send(ctx, signed); err != nil {
+		return fmt.Errorf("relay: delivering notice: %w", err)
+	}
+
+	return r.replay.MarkDelivered(ctx, key)
+}
@@ -0,0 +1,13 @@
+package relay
+
+import "context"
+
+// ReplayStore records which LockNotices have already been delivered, so
+// restarting the fetcher doesn't re-emit notices a Sink already
+// acknowledged.
+type ReplayStore interface {
+	// Seen reports whether key has already been marked delivered.
+	Seen(ctx context.Context, key Key) (bool, error)
+	// MarkDelivered records key as delivered.
+	MarkDelivered(ctx context.Context, key Key) error
+}
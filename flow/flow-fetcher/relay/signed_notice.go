@@ -0,0 +1,28 @@
+package relay
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+)
+
+// SignedLockNotice is a LockNotice plus the Ed25519 signature over its
+// canonical JSON encoding, so a downstream relayer can verify it came
+// from this fetcher before acting on it.
+type SignedLockNotice struct {
+	LockNotice
+	Signature []byte `json:"signature"`
+}
+
+// Sign produces the canonical JSON encoding of notice and signs it with
+// key, returning the signed envelope ready for delivery.
+func Sign(key ed25519.PrivateKey, notice LockNotice) (SignedLockNotice, error) {
+	payload, err := json.Marshal(notice)
+	if err != nil {
+		return SignedLockNotice{}, err
+	}
+
+	return SignedLockNotice{
+		LockNotice: notice,
+		Signature:  ed25519.Sign(key, payload),
+	}, nil
+}
@@ -0,0 +1,11 @@
+package relay
+
+import "context"
+
+// Sink delivers a SignedLockNotice to a downstream consumer, at least
+// once. LogSink is the only implementation this package ships today; a
+// NATS/Kafka or direct gRPC-to-validator Sink can be added behind this
+// same interface once there's a client for one to wire up against.
+type Sink interface {
+	Send(ctx context.Context, notice SignedLockNotice) error
+}
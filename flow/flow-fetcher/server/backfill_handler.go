@@ -0,0 +1,35 @@
+package server
+
+import "net/http"
+
+// handleBackfill serves GET /backfill. Called with a `topic` query param
+// it kicks off an async backfill job from that topic's stored cursor up
+// to the current latest sealed block, and returns the new job's id.
+// Called with `job_id` instead, it reports that job's current status.
+func (s *Server) handleBackfill(w http.ResponseWriter, r *http.Request) {
+	if jobId := r.URL.Query().Get("job_id"); jobId != "" {
+		job, ok := s.jobs.Status(jobId)
+		if !ok {
+			http.Error(w, "unknown job_id", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, job)
+		return
+	}
+
+	topic := r.URL.Query().Get("topic")
+	if topic == "" {
+		http.Error(w, "topic query param is required", http.StatusBadRequest)
+		return
+	}
+
+	jobId, err := s.jobs.Start(topic)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, struct {
+		JobId string `json:"jobId"`
+	}{JobId: jobId})
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/toni/flow-fetcher/flowapi"
+)
+
+// handleBlock serves GET /block, resolving a block by id or height query
+// param (or the latest sealed block if neither is given).
+func (s *Server) handleBlock(w http.ResponseWriter, r *http.Request) {
+	var info flowapi.BlockInfo
+	if err := bindQuery(r, &info); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	block, err := s.service.GetBlock(r.Context(), info)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, block)
+}
+
+// handleLatestBlock serves GET /latest_block.
+func (s *Server) handleLatestBlock(w http.ResponseWriter, r *http.Request) {
+	block, err := s.service.GetLatestBlock(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, block)
+}
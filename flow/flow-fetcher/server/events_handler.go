@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/toni/flow-fetcher/flowapi"
+)
+
+// handleEvents serves GET /events. Clients can opt into a push-based feed
+// instead of the default fetch-by-range via ?stream=ws or
+// ?stream=longpoll.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Query().Get("stream") {
+	case "ws":
+		s.serveEventsWebSocket(w, r)
+		return
+	case "longpoll":
+		s.serveEventsLongPoll(w, r)
+		return
+	}
+
+	var info flowapi.EventsInfo
+	if err := bindQuery(r, &info); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	events, err := s.service.GetEvents(r.Context(), info)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, events)
+}
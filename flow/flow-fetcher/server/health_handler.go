@@ -0,0 +1,21 @@
+package server
+
+import "net/http"
+
+// handleHealthz is the liveness probe: it only confirms the process is up
+// and serving.
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+// handleReadyz is the readiness probe: it pings the Flow access node so a
+// load balancer stops routing traffic here if that connection is down.
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if err := s.service.Ping(r.Context()); err != nil {
+		http.Error(w, "flow access node unreachable: "+err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
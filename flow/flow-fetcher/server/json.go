@@ -0,0 +1,19 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// writeJSON marshals v and writes it as the JSON response body, or a 500
+// if it can't be marshaled.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	js, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
@@ -0,0 +1,163 @@
+// File: openapi.go
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/toni/flow-fetcher/flowapi"
+)
+
+// openAPISpec is the OpenAPI 3 document describing this API, served at
+// /openapi.json and rendered by the Swagger UI at /docs. /events and
+// /block's parameter lists are derived from flowapi.EventsInfo/BlockInfo's
+// own `schema`/`validate` tags via queryParamsFromStruct, the same tags
+// bindQuery validates requests against, so the spec can't silently drift
+// out of sync with actual request handling the way a hand-authored copy
+// could.
+var openAPISpec = map[string]interface{}{
+	"openapi": "3.0.3",
+	"info": map[string]interface{}{
+		"title":   "flow-fetcher",
+		"version": "1.0.0",
+	},
+	"paths": map[string]interface{}{
+		"/events": map[string]interface{}{
+			"get": map[string]interface{}{
+				"summary": "Fetch, or subscribe to, decoded Flow events for a topic",
+				"parameters": append(queryParamsFromStruct(flowapi.EventsInfo{}),
+					// stream/timeout aren't part of EventsInfo; they're
+					// parsed ad hoc in parseStreamQuery for the
+					// ?stream=ws/longpoll modes.
+					queryParam("stream", "string", false),
+					queryParam("timeout", "integer", false),
+				),
+				"responses": okResponse(),
+			},
+		},
+		"/block": map[string]interface{}{
+			"get": map[string]interface{}{
+				"summary":    "Fetch a block by id or height, or the latest sealed block",
+				"parameters": queryParamsFromStruct(flowapi.BlockInfo{}),
+				"responses":  okResponse(),
+			},
+		},
+		"/latest_block": map[string]interface{}{
+			"get": map[string]interface{}{
+				"summary":   "Fetch the latest sealed block",
+				"responses": okResponse(),
+			},
+		},
+		"/backfill": map[string]interface{}{
+			"get": map[string]interface{}{
+				"summary": "Start an async backfill job for a topic, or poll one by job_id",
+				"parameters": []map[string]interface{}{
+					queryParam("topic", "string", false),
+					queryParam("job_id", "string", false),
+				},
+				"responses": okResponse(),
+			},
+		},
+		"/healthz": map[string]interface{}{
+			"get": map[string]interface{}{
+				"summary":   "Liveness probe",
+				"responses": okResponse(),
+			},
+		},
+		"/readyz": map[string]interface{}{
+			"get": map[string]interface{}{
+				"summary":   "Readiness probe; pings the Flow access node",
+				"responses": okResponse(),
+			},
+		},
+	},
+}
+
+func queryParam(name, schemaType string, required bool) map[string]interface{} {
+	return map[string]interface{}{
+		"name":     name,
+		"in":       "query",
+		"required": required,
+		"schema":   map[string]string{"type": schemaType},
+	}
+}
+
+// queryParamsFromStruct builds the OpenAPI parameter list for a query-bound
+// struct (one bindQuery decodes via `schema` tags and validates via
+// `validate` tags), so the spec always matches what the handler actually
+// accepts.
+func queryParamsFromStruct(v interface{}) []map[string]interface{} {
+	t := reflect.TypeOf(v)
+	params := make([]map[string]interface{}, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("schema")
+		if name == "" {
+			continue
+		}
+		params = append(params, queryParam(name, openAPIType(field.Type), hasRequiredTag(field.Tag.Get("validate"))))
+	}
+
+	return params
+}
+
+// openAPIType maps a Go field type to the OpenAPI schema type clients see
+// on the wire: query params are always strings on the wire, but numeric
+// fields are documented as "integer" since gorilla/schema parses them.
+func openAPIType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	default:
+		return "string"
+	}
+}
+
+// hasRequiredTag reports whether validateTag includes the bare "required"
+// rule, as opposed to a conditional variant like "required_without" or a
+// cross-field rule like "gtefield=...".
+func hasRequiredTag(validateTag string) bool {
+	for _, rule := range strings.Split(validateTag, ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+func okResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"200": map[string]interface{}{"description": "OK"},
+	}
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(openAPISpec)
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>flow-fetcher API docs</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function() {
+      SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>`
+
+func handleSwaggerUI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(swaggerUIPage))
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/toni/flow-fetcher/flowapi"
+)
+
+func findParam(params []map[string]interface{}, name string) (map[string]interface{}, bool) {
+	for _, p := range params {
+		if p["name"] == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+func TestQueryParamsFromStructMatchesEventsInfoValidation(t *testing.T) {
+	params := queryParamsFromStruct(flowapi.EventsInfo{})
+
+	cases := []struct {
+		name     string
+		required bool
+	}{
+		{"topic", true},
+		{"start_height", false},
+		{"end_height", false},
+		{"amount_format", false},
+	}
+
+	for _, c := range cases {
+		param, ok := findParam(params, c.name)
+		if !ok {
+			t.Fatalf("no parameter generated for %q", c.name)
+		}
+		if param["required"] != c.required {
+			t.Errorf("%s: required = %v, want %v", c.name, param["required"], c.required)
+		}
+	}
+}
+
+func TestQueryParamsFromStructTypesNumericFieldsAsInteger(t *testing.T) {
+	params := queryParamsFromStruct(flowapi.EventsInfo{})
+
+	param, ok := findParam(params, "start_height")
+	if !ok {
+		t.Fatal("no parameter generated for start_height")
+	}
+	schema, ok := param["schema"].(map[string]string)
+	if !ok || schema["type"] != "integer" {
+		t.Errorf("start_height schema = %v, want type integer", param["schema"])
+	}
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/schema"
+)
+
+var queryDecoder = newQueryDecoder()
+var validate = validator.New()
+
+func newQueryDecoder() *schema.Decoder {
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	return decoder
+}
+
+// bindQuery decodes r's query parameters into dst, a pointer to a struct
+// tagged with `schema:"..."`, then validates it against its
+// `validate:"..."` tags.
+func bindQuery(r *http.Request, dst interface{}) error {
+	if err := queryDecoder.Decode(dst, r.URL.Query()); err != nil {
+		return err
+	}
+	return validate.Struct(dst)
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/toni/flow-fetcher/flowapi"
+)
+
+func newEventsRequest(rawQuery string) *http.Request {
+	return &http.Request{URL: &url.URL{RawQuery: rawQuery}}
+}
+
+func TestBindQueryRejectsMissingTopic(t *testing.T) {
+	var info flowapi.EventsInfo
+	err := bindQuery(newEventsRequest("start_height=0&end_height=10"), &info)
+	if err == nil {
+		t.Fatal("expected an error for a missing required topic, got nil")
+	}
+}
+
+func TestBindQueryAcceptsZeroStartHeight(t *testing.T) {
+	var info flowapi.EventsInfo
+	err := bindQuery(newEventsRequest("topic=A.x.Foo.Bar&start_height=0&end_height=10"), &info)
+	if err != nil {
+		t.Fatalf("bindQuery: %v, want start_height=0 to be accepted", err)
+	}
+	if info.StartHeight != 0 || info.EndHeight != 10 {
+		t.Errorf("info = %+v, want StartHeight=0, EndHeight=10", info)
+	}
+}
+
+func TestBindQueryRejectsEndHeightBeforeStartHeight(t *testing.T) {
+	var info flowapi.EventsInfo
+	err := bindQuery(newEventsRequest("topic=A.x.Foo.Bar&start_height=10&end_height=5"), &info)
+	if err == nil {
+		t.Fatal("expected an error for end_height < start_height, got nil")
+	}
+}
+
+func TestBindQueryRejectsUnknownAmountFormat(t *testing.T) {
+	var info flowapi.EventsInfo
+	err := bindQuery(newEventsRequest("topic=A.x.Foo.Bar&start_height=0&end_height=10&amount_format=hex"), &info)
+	if err == nil {
+		t.Fatal("expected an error for an unrecognized amount_format, got nil")
+	}
+}
@@ -0,0 +1,50 @@
+// Package server is the flow-fetcher HTTP API: typed request binding and
+// validation, an auto-generated OpenAPI document and Swagger UI, and
+// standard middleware, built on gorilla/mux.
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/toni/flow-fetcher/fetcher"
+	"github.com/toni/flow-fetcher/flowapi"
+)
+
+// Server is the flow-fetcher HTTP API.
+type Server struct {
+	Router *mux.Router
+
+	service *flowapi.Service
+	streams *flowapi.StreamManager
+	jobs    *fetcher.JobManager
+}
+
+// New builds a Server with all routes, middleware, and docs registered.
+func New(service *flowapi.Service, streams *flowapi.StreamManager, jobs *fetcher.JobManager) *Server {
+	s := &Server{
+		Router:  mux.NewRouter(),
+		service: service,
+		streams: streams,
+		jobs:    jobs,
+	}
+
+	s.Router.Use(loggingMiddleware, recoveryMiddleware, corsMiddleware)
+
+	s.Router.HandleFunc("/events", s.handleEvents).Methods(http.MethodGet)
+	s.Router.HandleFunc("/block", s.handleBlock).Methods(http.MethodGet)
+	s.Router.HandleFunc("/latest_block", s.handleLatestBlock).Methods(http.MethodGet)
+	s.Router.HandleFunc("/backfill", s.handleBackfill).Methods(http.MethodGet)
+	s.Router.HandleFunc("/healthz", s.handleHealthz).Methods(http.MethodGet)
+	s.Router.HandleFunc("/readyz", s.handleReadyz).Methods(http.MethodGet)
+	s.Router.HandleFunc("/openapi.json", s.handleOpenAPI).Methods(http.MethodGet)
+	s.Router.HandleFunc("/docs", handleSwaggerUI).Methods(http.MethodGet)
+
+	return s
+}
+
+// ListenAndServe starts the server on addr.
+func (s *Server) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, s.Router)
+}
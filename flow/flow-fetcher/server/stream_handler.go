@@ -0,0 +1,159 @@
+// File: stream_handler.go
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/toni/flow-fetcher/flowapi"
+)
+
+const (
+	streamWriteWait        = 10 * time.Second
+	streamPongWait         = 60 * time.Second
+	streamPingPeriod       = (streamPongWait * 9) / 10
+	defaultLongPollTimeout = 30 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// this is a read-only event feed served to curl/browsers on arbitrary
+	// origins, so we don't gate the upgrade on Origin.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// serveEventsWebSocket upgrades the connection and streams FlowEvents for
+// the requested topic as they're sealed, starting from start_height (or
+// the next block after the current latest sealed block if unset).
+func (s *Server) serveEventsWebSocket(w http.ResponseWriter, r *http.Request) {
+	topic, startHeight, err := s.parseStreamQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Println("websocket upgrade failed:", err)
+		return
+	}
+	defer conn.Close()
+
+	sub, unsubscribe := s.streams.Subscribe(topic, startHeight)
+	defer unsubscribe()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	conn.SetReadDeadline(time.Now().Add(streamPongWait))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(streamPongWait))
+		return nil
+	})
+	// We only push data on this connection; drain and discard whatever the
+	// client sends (including close frames/pongs) and cancel on disconnect.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(streamPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-sub.Events:
+			conn.SetWriteDeadline(time.Now().Add(streamWriteWait))
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(streamWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// serveEventsLongPoll blocks until an event on the requested topic above
+// start_height is observed, or timeout elapses, then responds with the
+// (possibly empty) batch of events seen.
+func (s *Server) serveEventsLongPoll(w http.ResponseWriter, r *http.Request) {
+	topic, startHeight, err := s.parseStreamQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	timeout := defaultLongPollTimeout
+	if raw := r.URL.Query().Get("timeout"); raw != "" {
+		if seconds, err := strconv.Atoi(raw); err == nil && seconds > 0 {
+			timeout = time.Duration(seconds) * time.Second
+		}
+	}
+
+	sub, unsubscribe := s.streams.Subscribe(topic, startHeight)
+	defer unsubscribe()
+
+	var events []flowapi.FlowEvent
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	select {
+	case event := <-sub.Events:
+		events = append(events, event)
+	case <-deadline.C:
+	case <-r.Context().Done():
+		return
+	}
+
+	// drain whatever else is already queued without blocking any further.
+	for draining := true; draining; {
+		select {
+		case event := <-sub.Events:
+			events = append(events, event)
+		default:
+			draining = false
+		}
+	}
+
+	writeJSON(w, events)
+}
+
+// parseStreamQuery extracts the topic and start height for streaming
+// modes of /events, defaulting start_height to the block after the
+// current latest sealed block.
+func (s *Server) parseStreamQuery(r *http.Request) (string, uint64, error) {
+	topic := r.URL.Query().Get("topic")
+	if topic == "" {
+		return "", 0, fmt.Errorf("topic query param is required")
+	}
+
+	if raw := r.URL.Query().Get("start_height"); raw != "" {
+		startHeight, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid start_height: %w", err)
+		}
+		return topic, startHeight, nil
+	}
+
+	latestBlock, err := s.service.GetLatestBlock(r.Context())
+	if err != nil {
+		return "", 0, err
+	}
+	return topic, latestBlock.Height + 1, nil
+}
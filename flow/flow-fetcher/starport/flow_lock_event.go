@@ -23,12 +23,50 @@ func (evt FlowLockEvent) Recipient() *flow.Address {
 	return nil
 }
 
-func (evt FlowLockEvent) Amount() uint64 {
-	// return float64(evt.Fields[2].(cadence.UFix64).ToGoValue().(uint64)) / 1e8 // ufixed 64 have 8 digits of precision
-	return evt.Fields[2].(cadence.UFix64).ToGoValue().(uint64)
+// Amount returns the locked amount as a UFix64, preserving its 8 digits
+// of decimal precision.
+func (evt FlowLockEvent) Amount() UFix64 {
+	return UFix64(evt.Fields[2].(cadence.UFix64).ToGoValue().(uint64))
 }
 
 func (evt FlowLockEvent) String() string {
-	return fmt.Sprintf("Lock event: asset: %s, recipient: %s, amount: %d",
+	return fmt.Sprintf("Lock event: asset: %s, recipient: %s, amount: %s",
 		evt.Asset(), evt.Recipient(), evt.Amount())
 }
+
+// LockDecoder is the EventDecoder for a Starport contract's Lock event.
+type LockDecoder struct {
+	eventType string
+}
+
+// NewLockDecoder returns a LockDecoder for the Lock event emitted by the
+// Starport contract deployed at eventType (e.g.
+// "A.c8873a26b148ed14.Starport.Lock").
+func NewLockDecoder(eventType string) *LockDecoder {
+	return &LockDecoder{eventType: eventType}
+}
+
+func (d *LockDecoder) EventType() string {
+	return d.eventType
+}
+
+func (d *LockDecoder) Decode(event cadence.Event) (map[string]interface{}, error) {
+	lockEvent := FlowLockEvent(event)
+
+	var recipient string
+	if addr := lockEvent.Recipient(); addr != nil {
+		recipient = addr.String()
+	}
+
+	return map[string]interface{}{
+		"asset":     lockEvent.Asset(),
+		"recipient": recipient,
+		"amount":    lockEvent.Amount(),
+	}, nil
+}
+
+// RegisterDefaults registers the decoders this package ships with against
+// the Starport contract deployed at contractAddress.
+func RegisterDefaults(registry *Registry, contractAddress string) {
+	registry.Register(NewLockDecoder(fmt.Sprintf("A.%s.Starport.Lock", contractAddress)))
+}
@@ -0,0 +1,113 @@
+package starport
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/onflow/cadence"
+)
+
+// EventDecoder decodes a single Cadence event type into a JSON-serializable
+// map. EventType returns the fully-qualified Cadence event type the decoder
+// handles, e.g. "A.c8873a26b148ed14.Starport.Lock".
+type EventDecoder interface {
+	EventType() string
+	Decode(event cadence.Event) (map[string]interface{}, error)
+}
+
+// Registry looks up EventDecoders by the fully-qualified Cadence event type
+// they handle, falling back to a generic reflective decode for event types
+// nothing has registered a decoder for.
+type Registry struct {
+	mu       sync.RWMutex
+	decoders map[string]EventDecoder
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{decoders: make(map[string]EventDecoder)}
+}
+
+// Register adds decoder, keyed by decoder.EventType(). A later call with
+// the same EventType replaces the earlier decoder.
+func (r *Registry) Register(decoder EventDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.decoders[decoder.EventType()] = decoder
+}
+
+// Decode looks up the decoder registered for event.Type().ID() and uses it
+// to decode event, falling back to a generic reflective decode over the
+// event's composite fields if none is registered.
+func (r *Registry) Decode(event cadence.Event) (map[string]interface{}, error) {
+	r.mu.RLock()
+	decoder, ok := r.decoders[event.Type().ID()]
+	r.mu.RUnlock()
+
+	if ok {
+		return decoder.Decode(event)
+	}
+	return decodeGeneric(event)
+}
+
+// decodeGeneric walks event.Fields against the event's Cadence composite
+// type to build a JSON object, for event types without a registered
+// decoder. It never panics on an unexpected field shape; it returns an
+// error instead so one bad field fails just that event.
+func decodeGeneric(event cadence.Event) (map[string]interface{}, error) {
+	eventType, ok := event.Type().(*cadence.EventType)
+	if !ok {
+		return nil, fmt.Errorf("starport: event %s has no composite type information", event.Type().ID())
+	}
+
+	data := make(map[string]interface{}, len(eventType.Fields))
+	for i, field := range eventType.Fields {
+		if i >= len(event.Fields) {
+			break
+		}
+		value, err := decodeCadenceValue(event.Fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("starport: field %q: %w", field.Identifier, err)
+		}
+		data[field.Identifier] = value
+	}
+	return data, nil
+}
+
+// decodeCadenceValue converts a single Cadence value into a plain Go value
+// suitable for JSON encoding, recursing into optionals and arrays.
+func decodeCadenceValue(value cadence.Value) (interface{}, error) {
+	switch v := value.(type) {
+	case cadence.Optional:
+		if v.Value == nil {
+			return nil, nil
+		}
+		return decodeCadenceValue(v.Value)
+	case cadence.String:
+		return string(v), nil
+	case cadence.Bool:
+		return bool(v), nil
+	case cadence.Address:
+		return v.String(), nil
+	case cadence.UFix64:
+		return UFix64(v.ToGoValue().(uint64)), nil
+	case cadence.Array:
+		values := make([]interface{}, len(v.Values))
+		for i, elem := range v.Values {
+			decoded, err := decodeCadenceValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = decoded
+		}
+		return values, nil
+	default:
+		// Numeric Cadence types (UFix64, Int*, UInt*, Word*, Fix64, ...) all
+		// expose a Go value via ToGoValue(); anything else falls back to its
+		// String() rendering rather than failing the whole event.
+		if goer, ok := value.(interface{ ToGoValue() interface{} }); ok {
+			return goer.ToGoValue(), nil
+		}
+		return v.String(), nil
+	}
+}
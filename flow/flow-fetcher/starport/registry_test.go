@@ -0,0 +1,105 @@
+package starport
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func testEvent(qualifiedIdentifier string, fields []cadence.Field, values []cadence.Value) cadence.Event {
+	eventType := &cadence.EventType{
+		Location:            common.StringLocation("test"),
+		QualifiedIdentifier: qualifiedIdentifier,
+		Fields:              fields,
+	}
+	return cadence.NewEvent(values).WithType(eventType)
+}
+
+type fakeDecoder struct {
+	eventType string
+}
+
+func (d fakeDecoder) EventType() string { return d.eventType }
+
+func (d fakeDecoder) Decode(event cadence.Event) (map[string]interface{}, error) {
+	return map[string]interface{}{"decoded": true}, nil
+}
+
+func TestRegistryDispatchesToRegisteredDecoder(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(fakeDecoder{eventType: "S.test.Foo.Bar"})
+
+	event := testEvent("Foo.Bar", nil, nil)
+
+	data, err := registry.Decode(event)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if data["decoded"] != true {
+		t.Errorf("expected the registered fakeDecoder to handle the event, got %v", data)
+	}
+}
+
+func TestRegistryFallsBackToGenericDecode(t *testing.T) {
+	registry := NewRegistry()
+
+	fields := []cadence.Field{{Identifier: "name", Type: cadence.StringType{}}}
+	event := testEvent("Unregistered.Event", fields, []cadence.Value{cadence.String("hello")})
+
+	data, err := registry.Decode(event)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if got, want := data["name"], "hello"; got != want {
+		t.Errorf("generic decode: data[\"name\"] = %v, want %v", got, want)
+	}
+}
+
+func TestDecodeGenericStopsAtShorterFieldList(t *testing.T) {
+	// More declared fields than supplied values shouldn't panic - it
+	// should just stop at the shorter of the two.
+	fields := []cadence.Field{
+		{Identifier: "a", Type: cadence.StringType{}},
+		{Identifier: "b", Type: cadence.StringType{}},
+	}
+	event := testEvent("Partial.Event", fields, []cadence.Value{cadence.String("only-a")})
+
+	data, err := decodeGeneric(event)
+	if err != nil {
+		t.Fatalf("decodeGeneric: %v", err)
+	}
+	if _, ok := data["b"]; ok {
+		t.Errorf("expected no value for field b, got %v", data["b"])
+	}
+	if got, want := data["a"], "only-a"; got != want {
+		t.Errorf("data[\"a\"] = %v, want %v", got, want)
+	}
+}
+
+func TestDecodeCadenceValueOptionalAndArray(t *testing.T) {
+	some, err := decodeCadenceValue(cadence.NewOptional(cadence.String("present")))
+	if err != nil {
+		t.Fatalf("decodeCadenceValue(optional with value): %v", err)
+	}
+	if got, want := some, "present"; got != want {
+		t.Errorf("optional value = %v, want %v", got, want)
+	}
+
+	none, err := decodeCadenceValue(cadence.NewOptional(nil))
+	if err != nil {
+		t.Fatalf("decodeCadenceValue(nil optional): %v", err)
+	}
+	if none != nil {
+		t.Errorf("nil optional = %v, want nil", none)
+	}
+
+	array, err := decodeCadenceValue(cadence.NewArray([]cadence.Value{cadence.String("a"), cadence.String("b")}))
+	if err != nil {
+		t.Fatalf("decodeCadenceValue(array): %v", err)
+	}
+	values, ok := array.([]interface{})
+	if !ok || len(values) != 2 || values[0] != "a" || values[1] != "b" {
+		t.Errorf("array value = %v, want [a b]", array)
+	}
+}
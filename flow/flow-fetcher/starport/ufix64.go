@@ -0,0 +1,93 @@
+package starport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ufix64Scale is the number of decimal digits of precision a Cadence
+// UFix64 carries: its underlying uint64 is the value scaled by 1e8.
+const ufix64Scale = 100_000_000
+
+// UFix64 is a Cadence UFix64 value: a uint64 scaled by 1e8, giving 8
+// digits of fixed-point decimal precision. Its JSON encoding is the
+// canonical decimal string (e.g. "123.45000000") so downstream consumers
+// don't have to know the scale to read it correctly.
+type UFix64 uint64
+
+// Raw returns the underlying scaled uint64.
+func (u UFix64) Raw() uint64 {
+	return uint64(u)
+}
+
+// Float64 returns u as a float64. This loses precision for very large
+// amounts; prefer String() when exactness matters.
+func (u UFix64) Float64() float64 {
+	return float64(u) / ufix64Scale
+}
+
+// String renders u as a decimal string with exactly 8 fractional digits,
+// e.g. "123.45000000".
+func (u UFix64) String() string {
+	whole := uint64(u) / ufix64Scale
+	frac := uint64(u) % ufix64Scale
+	return fmt.Sprintf("%d.%08d", whole, frac)
+}
+
+// Add returns u + other. Like Cadence's own UFix64 arithmetic, it does
+// not check for overflow.
+func (u UFix64) Add(other UFix64) UFix64 {
+	return u + other
+}
+
+// Sub returns u - other. It does not check for underflow.
+func (u UFix64) Sub(other UFix64) UFix64 {
+	return u - other
+}
+
+// MarshalJSON emits u as its canonical decimal string.
+func (u UFix64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON accepts either the canonical decimal string or a bare
+// JSON integer, so data serialized before this type existed still parses.
+func (u *UFix64) UnmarshalJSON(data []byte) error {
+	var asString string
+	if err := json.Unmarshal(data, &asString); err == nil {
+		return u.parseDecimal(asString)
+	}
+
+	var asNumber uint64
+	if err := json.Unmarshal(data, &asNumber); err != nil {
+		return fmt.Errorf("starport: UFix64 must be a decimal string or integer, got %s", data)
+	}
+	*u = UFix64(asNumber)
+	return nil
+}
+
+func (u *UFix64) parseDecimal(s string) error {
+	whole, fracStr, hasFrac := strings.Cut(s, ".")
+
+	wholePart, err := strconv.ParseUint(whole, 10, 64)
+	if err != nil {
+		return fmt.Errorf("starport: invalid UFix64 %q: %w", s, err)
+	}
+
+	var fracPart uint64
+	if hasFrac {
+		if len(fracStr) > 8 {
+			return fmt.Errorf("starport: invalid UFix64 %q: more than 8 fractional digits", s)
+		}
+		fracStr += strings.Repeat("0", 8-len(fracStr))
+		fracPart, err = strconv.ParseUint(fracStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("starport: invalid UFix64 %q: %w", s, err)
+		}
+	}
+
+	*u = UFix64(wholePart*ufix64Scale + fracPart)
+	return nil
+}
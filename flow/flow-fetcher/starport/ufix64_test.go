@@ -0,0 +1,84 @@
+package starport
+
+import "testing"
+
+func TestUFix64StringRoundTrip(t *testing.T) {
+	cases := []struct {
+		raw  uint64
+		want string
+	}{
+		{0, "0.00000000"},
+		{100_000_000, "1.00000000"},
+		{123_450_000_00, "123.45000000"},
+		{1, "0.00000001"},
+	}
+
+	for _, c := range cases {
+		u := UFix64(c.raw)
+		if got := u.String(); got != c.want {
+			t.Errorf("UFix64(%d).String() = %q, want %q", c.raw, got, c.want)
+		}
+	}
+}
+
+func TestUFix64MarshalJSON(t *testing.T) {
+	u := UFix64(123_450_000_00)
+	data, err := u.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if got, want := string(data), `"123.45000000"`; got != want {
+		t.Errorf("MarshalJSON = %s, want %s", got, want)
+	}
+}
+
+func TestUFix64UnmarshalJSONDecimalString(t *testing.T) {
+	var u UFix64
+	if err := u.UnmarshalJSON([]byte(`"123.45000000"`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if want := UFix64(123_450_000_00); u != want {
+		t.Errorf("UnmarshalJSON = %d, want %d", u, want)
+	}
+}
+
+func TestUFix64UnmarshalJSONShortFraction(t *testing.T) {
+	var u UFix64
+	if err := u.UnmarshalJSON([]byte(`"1.5"`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if want := UFix64(150_000_000); u != want {
+		t.Errorf("UnmarshalJSON = %d, want %d", u, want)
+	}
+}
+
+func TestUFix64UnmarshalJSONBareInteger(t *testing.T) {
+	// Data serialized before this type existed is a bare JSON integer of
+	// the raw scaled value, not a decimal string.
+	var u UFix64
+	if err := u.UnmarshalJSON([]byte(`12345`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if want := UFix64(12345); u != want {
+		t.Errorf("UnmarshalJSON = %d, want %d", u, want)
+	}
+}
+
+func TestUFix64UnmarshalJSONTooManyFractionalDigits(t *testing.T) {
+	var u UFix64
+	if err := u.UnmarshalJSON([]byte(`"1.123456789"`)); err == nil {
+		t.Fatal("expected error for more than 8 fractional digits, got nil")
+	}
+}
+
+func TestUFix64ArithRoundTrip(t *testing.T) {
+	a := UFix64(100_000_000) // 1.0
+	b := UFix64(50_000_000)  // 0.5
+
+	if got, want := a.Add(b), UFix64(150_000_000); got != want {
+		t.Errorf("Add = %d, want %d", got, want)
+	}
+	if got, want := a.Sub(b), UFix64(50_000_000); got != want {
+		t.Errorf("Sub = %d, want %d", got, want)
+	}
+}